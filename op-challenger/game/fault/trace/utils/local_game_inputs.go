@@ -0,0 +1,17 @@
+package utils
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// LocalGameInputs are the inputs for a specific fault dispute game that are derived from the
+// chain state local to the challenger (ie not stored in the fault dispute game contract itself).
+type LocalGameInputs struct {
+	L1Head        common.Hash
+	L2Head        common.Hash
+	L2OutputRoot  common.Hash
+	L2Claim       common.Hash
+	L2BlockNumber *big.Int
+}
@@ -0,0 +1,81 @@
+package vm
+
+// Config defines the configuration needed to launch the fault proof VM (op-program) as a
+// subprocess, either to generate a full execution trace offline or to run as an online server
+// answering preimage requests on demand.
+type Config struct {
+	// L1 are the RPC endpoints of the L1 nodes to fetch L1 data from. Additional endpoints are
+	// used as failover when L1RPCFailoverStrategy is set.
+	L1 []string
+
+	// L1Beacon are the RPC endpoints of the L1 beacon nodes to fetch blob data from. Additional
+	// endpoints are used as failover when L1RPCFailoverStrategy is set.
+	L1Beacon []string
+
+	// L2s are the RPC endpoints of the L2 nodes to fetch L2 data from, one per L2 chain
+	// involved in the dispute (interop games reference more than one).
+	L2s []string
+
+	// L1RPCFailoverStrategy selects how additional L1 entries are used when the primary L1
+	// endpoint is unavailable. Only meaningful when more than one L1 endpoint is configured.
+	L1RPCFailoverStrategy RPCFailoverStrategy
+
+	// Server is the path to the op-program binary (or wrapper script) used to generate the
+	// trace or serve preimages.
+	Server string
+
+	// Networks are the named networks op-program should load built-in chain configs for.
+	Networks []string
+
+	// L2Custom indicates that the L2 chain is not one of the built-in networks op-program
+	// knows about, so an explicit rollup config and L2 genesis must be supplied.
+	L2Custom bool
+
+	// RollupConfigPaths are paths to the rollup configs to use, one per L2 chain being proven.
+	RollupConfigPaths []string
+
+	// L2GenesisPaths are paths to the L2 genesis files to use, one per L2 chain being proven.
+	L2GenesisPaths []string
+
+	// RollupConfigContents are inline rollup configs to use, one per L2 chain being proven.
+	// Cannot be combined with RollupConfigPaths: a config that sets both is rejected, even when
+	// they would cover different chains.
+	RollupConfigContents [][]byte
+
+	// L2GenesisContents are inline L2 genesis files to use, one per L2 chain being proven.
+	// Cannot be combined with L2GenesisPaths: a config that sets both is rejected, even when
+	// they would cover different chains.
+	L2GenesisContents [][]byte
+
+	// PreimageReadOnly opens the preimage kv store in the datadir read-only, so op-program can
+	// verify a dispute against a frozen preimage snapshot without being able to add or mutate
+	// preimages. Any request for a preimage missing from the snapshot fails hard instead of
+	// falling back to fetching it live.
+	PreimageReadOnly bool
+}
+
+// RPCFailoverStrategy selects how a client with multiple configured RPC endpoints picks between
+// them after the primary endpoint fails.
+type RPCFailoverStrategy string
+
+const (
+	// RPCFailoverSequential tries endpoints in the order they were configured, falling through
+	// to the next one on failure.
+	RPCFailoverSequential RPCFailoverStrategy = "sequential"
+
+	// RPCFailoverRandom picks a random endpoint to fail over to.
+	RPCFailoverRandom RPCFailoverStrategy = "random"
+
+	// RPCFailoverHedged races requests against multiple endpoints concurrently and uses
+	// whichever responds first.
+	RPCFailoverHedged RPCFailoverStrategy = "hedged"
+)
+
+func (s RPCFailoverStrategy) Valid() bool {
+	switch s {
+	case RPCFailoverSequential, RPCFailoverRandom, RPCFailoverHedged:
+		return true
+	default:
+		return false
+	}
+}
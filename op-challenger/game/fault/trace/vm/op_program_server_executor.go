@@ -0,0 +1,139 @@
+package vm
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/utils"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// OpProgramServerExecutor launches op-program in --server mode so it can respond to preimage
+// requests from the fault proof VM over the preimage oracle ABI.
+type OpProgramServerExecutor struct {
+	logger log.Logger
+}
+
+func NewOpProgramServerExecutor(logger log.Logger) *OpProgramServerExecutor {
+	return &OpProgramServerExecutor{logger: logger}
+}
+
+// OracleCommand builds the command line arguments to invoke op-program in online mode, ready to
+// respond to preimage requests from the fault proof VM.
+func (s *OpProgramServerExecutor) OracleCommand(cfg Config, dataDir string, inputs utils.LocalGameInputs) ([]string, error) {
+	if len(cfg.RollupConfigPaths) > 0 && len(cfg.RollupConfigContents) > 0 {
+		return nil, fmt.Errorf("cannot specify both RollupConfigPaths and RollupConfigContents")
+	}
+	if len(cfg.L2GenesisPaths) > 0 && len(cfg.L2GenesisContents) > 0 {
+		return nil, fmt.Errorf("cannot specify both L2GenesisPaths and L2GenesisContents")
+	}
+	if err := validateEndpoints("L1", cfg.L1); err != nil {
+		return nil, err
+	}
+	if err := validateEndpoints("L1Beacon", cfg.L1Beacon); err != nil {
+		return nil, err
+	}
+	if err := validateEndpoints("L2", cfg.L2s); err != nil {
+		return nil, err
+	}
+	if cfg.L1RPCFailoverStrategy != "" {
+		if !cfg.L1RPCFailoverStrategy.Valid() {
+			return nil, fmt.Errorf("invalid L1RPCFailoverStrategy: %q", cfg.L1RPCFailoverStrategy)
+		}
+		if len(cfg.L1) < 2 {
+			return nil, fmt.Errorf("L1RPCFailoverStrategy requires more than one L1 endpoint")
+		}
+	}
+
+	args := []string{
+		cfg.Server,
+		"--server",
+		"--l1", strings.Join(cfg.L1, ","),
+		"--l1.beacon", strings.Join(cfg.L1Beacon, ","),
+		"--l2", strings.Join(cfg.L2s, ","),
+		"--datadir", dataDir,
+		"--l1.head", inputs.L1Head.Hex(),
+		"--l2.head", inputs.L2Head.Hex(),
+		"--l2.outputroot", inputs.L2OutputRoot.Hex(),
+		"--l2.claim", inputs.L2Claim.Hex(),
+		"--l2.blocknumber", inputs.L2BlockNumber.String(),
+		"--log.level", logLevelFlag(s.logger),
+	}
+	if len(cfg.Networks) > 0 {
+		args = append(args, "--network", strings.Join(cfg.Networks, ","))
+	}
+	if len(cfg.RollupConfigPaths) > 0 {
+		args = append(args, "--rollup.config", strings.Join(cfg.RollupConfigPaths, ","))
+	}
+	if len(cfg.RollupConfigContents) > 0 {
+		args = append(args, "--rollup.config.content", joinBase64(cfg.RollupConfigContents))
+	}
+	if len(cfg.L2GenesisPaths) > 0 {
+		args = append(args, "--l2.genesis", strings.Join(cfg.L2GenesisPaths, ","))
+	}
+	if len(cfg.L2GenesisContents) > 0 {
+		args = append(args, "--l2.genesis.content", joinBase64(cfg.L2GenesisContents))
+	}
+	if cfg.L2Custom {
+		args = append(args, "--l2.custom")
+	}
+	if cfg.PreimageReadOnly {
+		args = append(args, "--datadir.readonly")
+	}
+	if cfg.L1RPCFailoverStrategy != "" {
+		args = append(args, "--l1.rpc-failover-strategy", string(cfg.L1RPCFailoverStrategy))
+	}
+	return args, nil
+}
+
+// validateEndpoints checks that at least one endpoint is configured for the given role and that
+// none of them are empty strings.
+func validateEndpoints(role string, endpoints []string) error {
+	if len(endpoints) == 0 {
+		return fmt.Errorf("at least one %v endpoint must be specified", role)
+	}
+	for _, endpoint := range endpoints {
+		if endpoint == "" {
+			return fmt.Errorf("%v endpoints must not be empty", role)
+		}
+	}
+	return nil
+}
+
+// joinBase64 base64-encodes each entry and joins them with a comma, mirroring how the path-based
+// flags accept one entry per L2 chain.
+func joinBase64(contents [][]byte) string {
+	encoded := make([]string, len(contents))
+	for i, content := range contents {
+		encoded[i] = base64.StdEncoding.EncodeToString(content)
+	}
+	return strings.Join(encoded, ",")
+}
+
+// logLevels maps slog levels to the string values accepted by op-program's --log.level flag, from
+// most to least verbose.
+var logLevels = []struct {
+	level slog.Level
+	name  string
+}{
+	{log.LevelTrace, "TRACE"},
+	{log.LevelDebug, "DEBUG"},
+	{log.LevelInfo, "INFO"},
+	{log.LevelWarn, "WARN"},
+	{log.LevelError, "ERROR"},
+	{log.LevelCrit, "CRIT"},
+}
+
+// logLevelFlag returns the --log.level value matching the lowest (most verbose) level the given
+// logger is configured to emit.
+func logLevelFlag(logger log.Logger) string {
+	for _, l := range logLevels {
+		if logger.Enabled(context.Background(), l.level) {
+			return l.name
+		}
+	}
+	return "CRIT"
+}
@@ -1,6 +1,7 @@
 package vm
 
 import (
+	"encoding/base64"
 	"fmt"
 	"log/slog"
 	"math/big"
@@ -17,11 +18,16 @@ import (
 func TestOpProgramFillHostCommand(t *testing.T) {
 	dir := "mockdir"
 
+	// boolFlags are flags that don't take a value after them on the command line.
+	boolFlags := map[string]bool{
+		"--l2.custom":        true,
+		"--datadir.readonly": true,
+	}
+
 	toPairs := func(args []string) map[string]string {
 		pairs := make(map[string]string, len(args)/2)
 		for i := 0; i < len(args); i += 2 {
-			// l2.custom is a boolean flag so can't accept a value after a space
-			if args[i] == "--l2.custom" {
+			if boolFlags[args[i]] {
 				pairs[args[i]] = "true"
 				i--
 				continue
@@ -33,8 +39,8 @@ func TestOpProgramFillHostCommand(t *testing.T) {
 
 	oracleCommand := func(t *testing.T, lvl slog.Level, configModifier func(c *Config)) map[string]string {
 		cfg := Config{
-			L1:       "http://localhost:8888",
-			L1Beacon: "http://localhost:9000",
+			L1:       []string{"http://localhost:8888"},
+			L1Beacon: []string{"http://localhost:9000"},
 			L2s:      []string{"http://localhost:9999", "http://localhost:9999/two"},
 			Server:   "./bin/mockserver",
 		}
@@ -53,8 +59,8 @@ func TestOpProgramFillHostCommand(t *testing.T) {
 		pairs := toPairs(args)
 		// Validate standard options
 		require.Equal(t, "--server", pairs[cfg.Server])
-		require.Equal(t, cfg.L1, pairs["--l1"])
-		require.Equal(t, cfg.L1Beacon, pairs["--l1.beacon"])
+		require.Equal(t, strings.Join(cfg.L1, ","), pairs["--l1"])
+		require.Equal(t, strings.Join(cfg.L1Beacon, ","), pairs["--l1.beacon"])
 		require.Equal(t, strings.Join(cfg.L2s, ","), pairs["--l2"])
 		require.Equal(t, dir, pairs["--datadir"])
 		require.Equal(t, inputs.L1Head.Hex(), pairs["--l1.head"])
@@ -70,6 +76,97 @@ func TestOpProgramFillHostCommand(t *testing.T) {
 		require.NotContains(t, pairs, "--network")
 		require.NotContains(t, pairs, "--rollup.config")
 		require.NotContains(t, pairs, "--l2.genesis")
+		require.NotContains(t, pairs, "--datadir.readonly")
+	})
+
+	t.Run("WithReadOnlyDatadir", func(t *testing.T) {
+		pairs := oracleCommand(t, log.LvlInfo, func(c *Config) {
+			c.PreimageReadOnly = true
+		})
+		require.Equal(t, "true", pairs["--datadir.readonly"])
+	})
+
+	t.Run("WithSingleL1Endpoint", func(t *testing.T) {
+		pairs := oracleCommand(t, log.LvlInfo, func(c *Config) {
+			c.L1 = []string{"http://localhost:8888"}
+		})
+		require.Equal(t, "http://localhost:8888", pairs["--l1"])
+		require.NotContains(t, pairs, "--l1.rpc-failover-strategy")
+	})
+
+	t.Run("WithMultipleL1Endpoints", func(t *testing.T) {
+		pairs := oracleCommand(t, log.LvlInfo, func(c *Config) {
+			c.L1 = []string{"http://localhost:8888", "http://backup:8888"}
+		})
+		require.Equal(t, "http://localhost:8888,http://backup:8888", pairs["--l1"])
+	})
+
+	t.Run("WithMultipleL1BeaconEndpoints", func(t *testing.T) {
+		pairs := oracleCommand(t, log.LvlInfo, func(c *Config) {
+			c.L1Beacon = []string{"http://localhost:9000", "http://backup:9000"}
+		})
+		require.Equal(t, "http://localhost:9000,http://backup:9000", pairs["--l1.beacon"])
+	})
+
+	for _, strategy := range []RPCFailoverStrategy{RPCFailoverSequential, RPCFailoverRandom, RPCFailoverHedged} {
+		strategy := strategy
+		t.Run(fmt.Sprintf("WithFailoverStrategy-%v", strategy), func(t *testing.T) {
+			pairs := oracleCommand(t, log.LvlInfo, func(c *Config) {
+				c.L1 = []string{"http://localhost:8888", "http://backup:8888"}
+				c.L1RPCFailoverStrategy = strategy
+			})
+			require.Equal(t, string(strategy), pairs["--l1.rpc-failover-strategy"])
+		})
+	}
+
+	t.Run("RejectsEmptyL1", func(t *testing.T) {
+		cfg := Config{
+			L1:       []string{},
+			L1Beacon: []string{"http://localhost:9000"},
+			L2s:      []string{"http://localhost:9999"},
+			Server:   "./bin/mockserver",
+		}
+		executor := NewOpProgramServerExecutor(testlog.Logger(t, log.LvlInfo))
+		_, err := executor.OracleCommand(cfg, dir, utils.LocalGameInputs{L2BlockNumber: big.NewInt(1)})
+		require.ErrorContains(t, err, "L1")
+	})
+
+	t.Run("RejectsEmptyL1EndpointString", func(t *testing.T) {
+		cfg := Config{
+			L1:       []string{"http://localhost:8888", ""},
+			L1Beacon: []string{"http://localhost:9000"},
+			L2s:      []string{"http://localhost:9999"},
+			Server:   "./bin/mockserver",
+		}
+		executor := NewOpProgramServerExecutor(testlog.Logger(t, log.LvlInfo))
+		_, err := executor.OracleCommand(cfg, dir, utils.LocalGameInputs{L2BlockNumber: big.NewInt(1)})
+		require.ErrorContains(t, err, "L1")
+	})
+
+	t.Run("RejectsFailoverStrategyWithSingleL1Endpoint", func(t *testing.T) {
+		cfg := Config{
+			L1:                    []string{"http://localhost:8888"},
+			L1Beacon:              []string{"http://localhost:9000"},
+			L2s:                   []string{"http://localhost:9999"},
+			Server:                "./bin/mockserver",
+			L1RPCFailoverStrategy: RPCFailoverSequential,
+		}
+		executor := NewOpProgramServerExecutor(testlog.Logger(t, log.LvlInfo))
+		_, err := executor.OracleCommand(cfg, dir, utils.LocalGameInputs{L2BlockNumber: big.NewInt(1)})
+		require.ErrorContains(t, err, "L1RPCFailoverStrategy")
+	})
+
+	t.Run("RejectsUnknownFailoverStrategy", func(t *testing.T) {
+		cfg := Config{
+			L1:                    []string{"http://localhost:8888", "http://backup:8888"},
+			L1Beacon:              []string{"http://localhost:9000"},
+			L2s:                   []string{"http://localhost:9999"},
+			Server:                "./bin/mockserver",
+			L1RPCFailoverStrategy: RPCFailoverStrategy("bogus"),
+		}
+		executor := NewOpProgramServerExecutor(testlog.Logger(t, log.LvlInfo))
+		_, err := executor.OracleCommand(cfg, dir, utils.LocalGameInputs{L2BlockNumber: big.NewInt(1)})
+		require.ErrorContains(t, err, "L1RPCFailoverStrategy")
 	})
 
 	t.Run("WithNetwork", func(t *testing.T) {
@@ -121,6 +218,76 @@ func TestOpProgramFillHostCommand(t *testing.T) {
 		require.Equal(t, "genesis.json,genesis2.json", pairs["--l2.genesis"])
 	})
 
+	t.Run("WithRollupConfigContent", func(t *testing.T) {
+		pairs := oracleCommand(t, log.LvlInfo, func(c *Config) {
+			c.RollupConfigContents = [][]byte{[]byte("rollup-config-a")}
+		})
+		require.Equal(t, base64.StdEncoding.EncodeToString([]byte("rollup-config-a")), pairs["--rollup.config.content"])
+		require.NotContains(t, pairs, "--rollup.config")
+	})
+
+	t.Run("WithMultipleRollupConfigContents", func(t *testing.T) {
+		pairs := oracleCommand(t, log.LvlInfo, func(c *Config) {
+			c.RollupConfigContents = [][]byte{[]byte("rollup-config-a"), []byte("rollup-config-b")}
+		})
+		expected := strings.Join([]string{
+			base64.StdEncoding.EncodeToString([]byte("rollup-config-a")),
+			base64.StdEncoding.EncodeToString([]byte("rollup-config-b")),
+		}, ",")
+		require.Equal(t, expected, pairs["--rollup.config.content"])
+	})
+
+	t.Run("WithL2GenesisContent", func(t *testing.T) {
+		pairs := oracleCommand(t, log.LvlInfo, func(c *Config) {
+			c.L2GenesisContents = [][]byte{[]byte("genesis-a")}
+		})
+		require.Equal(t, base64.StdEncoding.EncodeToString([]byte("genesis-a")), pairs["--l2.genesis.content"])
+		require.NotContains(t, pairs, "--l2.genesis")
+	})
+
+	t.Run("WithMultipleL2GenesisContents", func(t *testing.T) {
+		pairs := oracleCommand(t, log.LvlInfo, func(c *Config) {
+			c.L2GenesisContents = [][]byte{[]byte("genesis-a"), []byte("genesis-b")}
+		})
+		expected := strings.Join([]string{
+			base64.StdEncoding.EncodeToString([]byte("genesis-a")),
+			base64.StdEncoding.EncodeToString([]byte("genesis-b")),
+		}, ",")
+		require.Equal(t, expected, pairs["--l2.genesis.content"])
+	})
+
+	t.Run("RollupConfigPathAndContentAreMutuallyExclusive", func(t *testing.T) {
+		cfg := Config{
+			L1:                []string{"http://localhost:8888"},
+			L1Beacon:          []string{"http://localhost:9000"},
+			L2s:               []string{"http://localhost:9999"},
+			Server:            "./bin/mockserver",
+			RollupConfigPaths: []string{"rollup.config.json"},
+			RollupConfigContents: [][]byte{
+				[]byte("rollup-config-a"),
+			},
+		}
+		executor := NewOpProgramServerExecutor(testlog.Logger(t, log.LvlInfo))
+		_, err := executor.OracleCommand(cfg, dir, utils.LocalGameInputs{L2BlockNumber: big.NewInt(1)})
+		require.ErrorContains(t, err, "RollupConfigPaths")
+	})
+
+	t.Run("L2GenesisPathAndContentAreMutuallyExclusive", func(t *testing.T) {
+		cfg := Config{
+			L1:             []string{"http://localhost:8888"},
+			L1Beacon:       []string{"http://localhost:9000"},
+			L2s:            []string{"http://localhost:9999"},
+			Server:         "./bin/mockserver",
+			L2GenesisPaths: []string{"genesis.json"},
+			L2GenesisContents: [][]byte{
+				[]byte("genesis-a"),
+			},
+		}
+		executor := NewOpProgramServerExecutor(testlog.Logger(t, log.LvlInfo))
+		_, err := executor.OracleCommand(cfg, dir, utils.LocalGameInputs{L2BlockNumber: big.NewInt(1)})
+		require.ErrorContains(t, err, "L2GenesisPaths")
+	})
+
 	t.Run("WithAllExtras", func(t *testing.T) {
 		pairs := oracleCommand(t, log.LvlInfo, func(c *Config) {
 			c.Networks = []string{"op-test"}